@@ -0,0 +1,21 @@
+package dint
+
+// Calendar Selects the reckoning used to interpret and convert a dint.
+type Calendar int
+
+const (
+	// Gregorian is the proleptic Gregorian calendar, applied at all dates. This is the zero value.
+	Gregorian Calendar = iota
+	// Julian is the proleptic Julian calendar, applied at all dates.
+	Julian
+	// English is the Julian calendar before 1752-09-14 and the Gregorian
+	// calendar from 1752-09-14 onward, matching the British Calendar Act of
+	// 1750 (the 11 days from 1752-09-03 to 1752-09-13 do not exist).
+	English
+)
+
+const (
+	englishCutoverYear = 1752
+	englishCutoverDint = 17520914
+	englishCutoverJDN  = 2361222
+)
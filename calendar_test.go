@@ -0,0 +1,43 @@
+package dint
+
+import "testing"
+
+func TestCalendarJulianDayRoundTrip(t *testing.T) {
+	for _, cal := range []Calendar{Gregorian, Julian, English} {
+		d := Dint{Calendar: cal}
+		for _, v := range []int{20230101, 20000229, 19990101, 17000315, 500101, 10101} {
+			jdn := d.ToJulianDay(v)
+			if back := d.FromJulianDay(jdn); back != v {
+				t.Fatalf("calendar=%v: ToJulianDay/FromJulianDay(%d) round-trips to %d via jdn %d", cal, v, back, jdn)
+			}
+		}
+	}
+}
+
+func TestEnglishCalendarSkipsElevenDays(t *testing.T) {
+	eng := Dint{Calendar: English}
+	if next := eng.AddDays(17520902, 1); next != 17520914 {
+		t.Fatalf("AddDays(1752-09-02, 1) = %d, want 17520914 (the 11-day cutover gap)", next)
+	}
+	if diff := eng.Diff(17520914, 17520902); diff != 1 {
+		t.Fatalf("Diff(1752-09-14, 1752-09-02) = %d, want 1", diff)
+	}
+}
+
+func TestJulianLeapYearDiffersFromGregorianAtCenturies(t *testing.T) {
+	jul := Dint{Calendar: Julian}
+	greg := Dint{Calendar: Gregorian}
+	if !jul.IsLeapYear(1900) {
+		t.Fatal("1900 should be a leap year under the Julian rule (year%4==0)")
+	}
+	if greg.IsLeapYear(1900) {
+		t.Fatal("1900 should not be a leap year under the Gregorian rule")
+	}
+}
+
+func TestZeroValueDintIsGregorian(t *testing.T) {
+	var d Dint
+	if d.Calendar != Gregorian {
+		t.Fatalf("zero value Calendar = %v, want Gregorian", d.Calendar)
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 // Dint Handling dates in a human-readable integer format (dint).
 // Example: 20230912 = September 12, 2023
+// The zero value uses the Gregorian calendar; set Calendar to switch to
+// Julian or mixed Julian/Gregorian (English) reckoning.
 type Dint struct {
+	Calendar Calendar
 }
 
 // Create Creates a dint using given year, month and day.
@@ -29,17 +32,37 @@ func (dint Dint) Compose(year, month, day int) int {
 
 // Year Returns the year of a given dint.
 func (dint Dint) Year(year int) int {
-	return year / 10000
+	return floorDiv(year, 10000)
 }
 
 // Month Returns the month of a given dint.
 func (dint Dint) Month(month int) int {
-	return month / 100 % 100
+	return floorMod(month, 10000) / 100
 }
 
 // Day Returns the day of the month of a given dint.
 func (dint Dint) Day(day int) int {
-	return day % 100
+	return floorMod(day, 100)
+}
+
+// floorDiv is integer division rounded toward negative infinity, unlike Go's
+// built-in truncating division. Needed so BC dints (packed with year<=0) decompose
+// back into the right year/month/day instead of truncating toward zero.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod is the remainder complementing floorDiv, always taking the sign of b.
+func floorMod(a, b int) int {
+	m := a % b
+	if m != 0 && ((m < 0) != (b < 0)) {
+		m += b
+	}
+	return m
 }
 
 // ToDate Creates a time.Time instance from a given dint.
@@ -87,6 +110,20 @@ func (dint Dint) DaysInAMonth(year, month int) int {
 
 // IsLeapYear Returns if a given year is a leap year.
 func (dint Dint) IsLeapYear(year int) bool {
+	switch dint.Calendar {
+	case Julian:
+		return year%4 == 0
+	case English:
+		if year < englishCutoverYear {
+			return year%4 == 0
+		}
+		return isLeapGregorian(year)
+	default:
+		return isLeapGregorian(year)
+	}
+}
+
+func isLeapGregorian(year int) bool {
 	return !((year%4 != 0) || ((year%100 == 0) && (year%400 != 0)))
 }
 
@@ -139,10 +176,39 @@ func (dint Dint) ComposeExtend(param int, year, month int) int {
 	return dint.ComposeLimit(param, year, month)
 }
 
-// ToJulianDay Converts a dint to Julian Day.
+// ToJulianDay Converts a dint to Julian Day, honoring dint.Calendar.
 func (dint Dint) ToJulianDay(param int) int {
 	year, month, day := dint.Year(param), dint.Month(param), dint.Day(param)
 
+	switch dint.Calendar {
+	case Julian:
+		return toJulianDayJulian(year, month, day)
+	case English:
+		if param < englishCutoverDint {
+			return toJulianDayJulian(year, month, day)
+		}
+		return toJulianDayGregorian(year, month, day)
+	default:
+		return toJulianDayGregorian(year, month, day)
+	}
+}
+
+// FromJulianDay Converts Julian Day to a dint, honoring dint.Calendar.
+func (dint Dint) FromJulianDay(julianDay int) int {
+	switch dint.Calendar {
+	case Julian:
+		return fromJulianDayJulian(dint, julianDay)
+	case English:
+		if julianDay < englishCutoverJDN {
+			return fromJulianDayJulian(dint, julianDay)
+		}
+		return fromJulianDayGregorian(dint, julianDay)
+	default:
+		return fromJulianDayGregorian(dint, julianDay)
+	}
+}
+
+func toJulianDayGregorian(year, month, day int) int {
 	a := (14 - month) / 12
 	y := year + 4800 - a
 	m := month + 12*a - 3
@@ -150,8 +216,7 @@ func (dint Dint) ToJulianDay(param int) int {
 	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
 }
 
-// FromJulianDay Converts Julian Day to a dint.
-func (dint Dint) FromJulianDay(julianDay int) int {
+func fromJulianDayGregorian(dint Dint, julianDay int) int {
 	p := julianDay + 68569
 	q := 4 * p / 146097
 	r := p - (146097*q+3)/4
@@ -166,3 +231,24 @@ func (dint Dint) FromJulianDay(julianDay int) int {
 
 	return dint.Create(Y, M, D)
 }
+
+func toJulianDayJulian(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+func fromJulianDayJulian(dint Dint, julianDay int) int {
+	c := julianDay + 32082
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	D := e - (153*m+2)/5 + 1
+	M := m + 3 - 12*(m/10)
+	Y := d - 4800 + m/10
+
+	return dint.Create(Y, M, D)
+}
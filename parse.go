@@ -0,0 +1,293 @@
+package dint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tokenKind identifies a single element of a parsed layout string.
+type tokenKind int
+
+const (
+	tokLiteral tokenKind = iota
+	tokYearLong
+	tokYearShort
+	tokMonthLong
+	tokMonthShort
+	tokMonthPad
+	tokMonthNoPad
+	tokDayPad
+	tokDaySpace
+	tokDayNoPad
+	tokDayOfYearPad
+	tokDayOfYearSpace
+)
+
+// layoutToken is one element of a layout broken down by parseLayout.
+type layoutToken struct {
+	kind tokenKind
+	lit  string // only set when kind == tokLiteral
+}
+
+// layoutPrefixes lists the recognized reference tokens, longest first so that
+// e.g. "2006" is matched before "2" and "002" before "02".
+var layoutPrefixes = []struct {
+	pat  string
+	kind tokenKind
+}{
+	{"2006", tokYearLong},
+	{"January", tokMonthLong},
+	{"Jan", tokMonthShort},
+	{"002", tokDayOfYearPad},
+	{"__2", tokDayOfYearSpace},
+	{"01", tokMonthPad},
+	{"02", tokDayPad},
+	{"_2", tokDaySpace},
+	{"06", tokYearShort},
+	{"1", tokMonthNoPad},
+	{"2", tokDayNoPad},
+}
+
+// parseLayout breaks a reference layout such as "2006-01-02" into a sequence
+// of tokens, each either a recognized field or a literal run of text.
+func parseLayout(layout string) []layoutToken {
+	var tokens []layoutToken
+	for len(layout) > 0 {
+		matched := false
+		for _, p := range layoutPrefixes {
+			if strings.HasPrefix(layout, p.pat) {
+				tokens = append(tokens, layoutToken{kind: p.kind})
+				layout = layout[len(p.pat):]
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		r := layout[:1]
+		if n := len(tokens); n > 0 && tokens[n-1].kind == tokLiteral {
+			tokens[n-1].lit += r
+		} else {
+			tokens = append(tokens, layoutToken{kind: tokLiteral, lit: r})
+		}
+		layout = layout[1:]
+	}
+	return tokens
+}
+
+// Format Formats a dint using a Go-style reference layout ("2006-01-02",
+// "20060102", "02/01/2006", "2006-002" for day-of-year, and so on). Years
+// less than or equal to zero are rendered as 1-year with a " BC" suffix,
+// mirroring the convention used by Postgres and similar systems.
+func (dint Dint) Format(param int, layout string) string {
+	year, month, day := dint.Year(param), dint.Month(param), dint.Day(param)
+	bc := false
+	if year <= 0 {
+		year = 1 - year
+		bc = true
+	}
+
+	var sb strings.Builder
+	for _, tok := range parseLayout(layout) {
+		switch tok.kind {
+		case tokLiteral:
+			sb.WriteString(tok.lit)
+		case tokYearLong:
+			sb.WriteString(fmt.Sprintf("%04d", year))
+		case tokYearShort:
+			sb.WriteString(fmt.Sprintf("%02d", year%100))
+		case tokMonthLong:
+			sb.WriteString(time.Month(month).String())
+		case tokMonthShort:
+			sb.WriteString(time.Month(month).String()[:3])
+		case tokMonthPad:
+			sb.WriteString(fmt.Sprintf("%02d", month))
+		case tokMonthNoPad:
+			sb.WriteString(strconv.Itoa(month))
+		case tokDayPad:
+			sb.WriteString(fmt.Sprintf("%02d", day))
+		case tokDaySpace:
+			sb.WriteString(fmt.Sprintf("%2d", day))
+		case tokDayNoPad:
+			sb.WriteString(strconv.Itoa(day))
+		case tokDayOfYearPad:
+			sb.WriteString(fmt.Sprintf("%03d", dint.DayOfYear(param)))
+		case tokDayOfYearSpace:
+			sb.WriteString(fmt.Sprintf("%3d", dint.DayOfYear(param)))
+		}
+	}
+	if bc {
+		sb.WriteString(" BC")
+	}
+	return sb.String()
+}
+
+// digits consumes up to max leading digit characters from value (at least
+// min), returning the parsed number and the unconsumed remainder.
+func digits(value string, min, max int) (n int, rest string, err error) {
+	i := 0
+	for i < len(value) && i < max && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+	if i < min {
+		return 0, value, fmt.Errorf("dint: cannot parse %q, expected at least %d digit(s)", value, min)
+	}
+	n, err = strconv.Atoi(value[:i])
+	if err != nil {
+		return 0, value, fmt.Errorf("dint: cannot parse %q as a number", value[:i])
+	}
+	return n, value[i:], nil
+}
+
+func matchMonthName(value string, full bool) (month int, rest string, err error) {
+	for m := time.January; m <= time.December; m++ {
+		name := m.String()
+		if !full {
+			name = name[:3]
+		}
+		if len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+			return int(m), value[len(name):], nil
+		}
+	}
+	return 0, value, fmt.Errorf("dint: cannot parse %q as a month name", value)
+}
+
+// parse is the shared implementation behind Parse and ParseInLocation.
+// When fillMissing is true, any of year/month/day absent from the layout is
+// taken from today instead of causing an error.
+func (dint Dint) parse(layout, value string, fillMissing bool) (int, error) {
+	bc := false
+	if trimmed := strings.TrimSuffix(value, " BC"); trimmed != value {
+		value, bc = trimmed, true
+	} else if trimmed := strings.TrimSuffix(value, "BC"); trimmed != value {
+		value, bc = trimmed, true
+	}
+
+	var year, month, day, doy int
+	var haveYear, haveMonth, haveDay, haveDoY bool
+
+	rest := value
+	for _, tok := range parseLayout(layout) {
+		var err error
+		switch tok.kind {
+		case tokLiteral:
+			if !strings.HasPrefix(rest, tok.lit) {
+				return 0, fmt.Errorf("dint: value %q does not match layout %q", value, layout)
+			}
+			rest = rest[len(tok.lit):]
+		case tokYearLong:
+			year, rest, err = digits(rest, 4, 4)
+			haveYear = true
+		case tokYearShort:
+			var y int
+			y, rest, err = digits(rest, 2, 2)
+			year, haveYear = 2000+y, true
+		case tokMonthLong:
+			month, rest, err = matchMonthName(rest, true)
+			haveMonth = true
+		case tokMonthShort:
+			month, rest, err = matchMonthName(rest, false)
+			haveMonth = true
+		case tokMonthPad:
+			month, rest, err = digits(rest, 2, 2)
+			haveMonth = true
+		case tokMonthNoPad:
+			month, rest, err = digits(rest, 1, 2)
+			haveMonth = true
+		case tokDayPad:
+			day, rest, err = digits(rest, 2, 2)
+			haveDay = true
+		case tokDaySpace:
+			rest = strings.TrimPrefix(rest, " ")
+			day, rest, err = digits(rest, 1, 2)
+			haveDay = true
+		case tokDayNoPad:
+			day, rest, err = digits(rest, 1, 2)
+			haveDay = true
+		case tokDayOfYearPad:
+			doy, rest, err = digits(rest, 3, 3)
+			haveDoY = true
+		case tokDayOfYearSpace:
+			rest = strings.TrimLeft(rest, " ")
+			doy, rest, err = digits(rest, 1, 3)
+			haveDoY = true
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if rest != "" {
+		return 0, fmt.Errorf("dint: unexpected trailing text %q in %q", rest, value)
+	}
+
+	if fillMissing {
+		today := dint.Today()
+		if !haveYear {
+			year = dint.Year(today)
+		}
+		if !haveMonth && !haveDoY {
+			month = dint.Month(today)
+		}
+		if !haveDay && !haveDoY {
+			day = dint.Day(today)
+		}
+	} else {
+		if !haveYear {
+			return 0, fmt.Errorf("dint: layout %q does not specify a year", layout)
+		}
+		if !haveDoY && (!haveMonth || !haveDay) {
+			return 0, fmt.Errorf("dint: layout %q does not fully specify a month and day", layout)
+		}
+	}
+
+	astroYear := year
+	if bc {
+		astroYear = 1 - year
+	}
+
+	if haveDoY {
+		result := dint.AddDays(dint.Create(astroYear, 1, 1), doy-1)
+		if dint.Year(result) != astroYear {
+			return 0, fmt.Errorf("dint: day of year %d is out of range for year %d", doy, year)
+		}
+		return result, nil
+	}
+
+	if month < 1 || month > 12 {
+		return 0, fmt.Errorf("dint: month %d is invalid", month)
+	}
+	if day < 1 || day > dint.DaysInAMonth(astroYear, month) {
+		return 0, fmt.Errorf("dint: day %d is invalid for %04d-%02d", day, year, month)
+	}
+
+	result := dint.Create(astroYear, month, day)
+	return result, nil
+}
+
+// Parse Parses value according to a Go-style reference layout (e.g.
+// "2006-01-02") and returns the resulting dint. The layout must fully
+// specify a year, and either a month and day or a day-of-year ("002"); Parse
+// returns an error for layouts that leave any of those unset, for values
+// that don't match the layout, and for dates that don't exist (validated
+// against DaysInAMonth).
+func (dint Dint) Parse(layout, value string) (int, error) {
+	return dint.parse(layout, value, false)
+}
+
+// MustParse Parses value like Parse, but panics instead of returning an error.
+func (dint Dint) MustParse(layout, value string) int {
+	result, err := dint.Parse(layout, value)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseInLocation Parses value like Parse, but fills any of year, month or
+// day left unspecified by the layout from Today() instead of returning an error.
+func (dint Dint) ParseInLocation(layout, value string) (int, error) {
+	return dint.parse(layout, value, true)
+}
@@ -0,0 +1,142 @@
+package dint
+
+import "testing"
+
+func TestParseFormatRoundTrip(t *testing.T) {
+	var d Dint
+	cases := []struct {
+		layout string
+		value  string
+		want   int
+	}{
+		{"2006-01-02", "2023-09-12", 20230912},
+		{"20060102", "20230912", 20230912},
+		{"02/01/2006", "12/09/2023", 20230912},
+		{"Jan 2, 2006", "Sep 12, 2023", 20230912},
+	}
+	for _, c := range cases {
+		got, err := d.Parse(c.layout, c.value)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q) returned error: %v", c.layout, c.value, err)
+		}
+		if got != c.want {
+			t.Fatalf("Parse(%q, %q) = %d, want %d", c.layout, c.value, got, c.want)
+		}
+		if back := d.Format(got, c.layout); back != c.value {
+			t.Fatalf("Format(%d, %q) = %q, want %q", got, c.layout, back, c.value)
+		}
+	}
+}
+
+func TestParseFormatDayOfYear(t *testing.T) {
+	var d Dint
+	got, err := d.Parse("2006-002", "2023-045")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if want := 20230214; got != want {
+		t.Fatalf("Parse(day-of-year) = %d, want %d", got, want)
+	}
+	if back := d.Format(got, "2006-002"); back != "2023-045" {
+		t.Fatalf("Format(day-of-year) = %q, want %q", back, "2023-045")
+	}
+}
+
+func TestParseFormatBC(t *testing.T) {
+	var d Dint
+	got, err := d.Parse("2006-01-02", "0044-03-15 BC")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if got != -429685 {
+		t.Fatalf("Parse(BC) = %d, want -429685", got)
+	}
+	if y := d.Year(got); y != -43 {
+		t.Fatalf("Year(%d) = %d, want -43", got, y)
+	}
+	if m := d.Month(got); m != 3 {
+		t.Fatalf("Month(%d) = %d, want 3", got, m)
+	}
+	if day := d.Day(got); day != 15 {
+		t.Fatalf("Day(%d) = %d, want 15", got, day)
+	}
+	if back := d.Format(got, "2006-01-02"); back != "0044-03-15 BC" {
+		t.Fatalf("Format(BC) = %q, want %q", back, "0044-03-15 BC")
+	}
+}
+
+func TestParseFormatBCDayOfYear(t *testing.T) {
+	var d Dint
+	got, err := d.Parse("2006-002", "0044-045 BC")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if y := d.Year(got); y != -43 {
+		t.Fatalf("Year(%d) = %d, want -43", got, y)
+	}
+	if m := d.Month(got); m != 2 {
+		t.Fatalf("Month(%d) = %d, want 2", got, m)
+	}
+	if day := d.Day(got); day != 14 {
+		t.Fatalf("Day(%d) = %d, want 14", got, day)
+	}
+	if back := d.Format(got, "2006-002"); back != "0044-045 BC" {
+		t.Fatalf("Format(BC day-of-year) = %q, want %q", back, "0044-045 BC")
+	}
+}
+
+func TestParseFormatBCLeapDay(t *testing.T) {
+	var d Dint
+	// "0001 BC" is astronomical year 0, a leap year under the proleptic
+	// Gregorian calendar (0 % 400 == 0), so Feb 29 is valid.
+	got, err := d.Parse("2006-01-02", "0001-02-29 BC")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if back := d.Format(got, "2006-01-02"); back != "0001-02-29 BC" {
+		t.Fatalf("Format(BC leap day) = %q, want %q", back, "0001-02-29 BC")
+	}
+
+	if _, err := d.Parse("2006-01-02", "0002-02-29 BC"); err == nil {
+		t.Fatal("Parse(0002-02-29 BC) should have failed: astronomical year -1 is not a leap year")
+	}
+}
+
+func TestParseRejectsImpossibleDates(t *testing.T) {
+	var d Dint
+	if _, err := d.Parse("2006-01-02", "2023-02-30"); err == nil {
+		t.Fatal("expected an error for 2023-02-30 (not a leap year)")
+	}
+}
+
+func TestParseRejectsIncompleteLayout(t *testing.T) {
+	var d Dint
+	if _, err := d.Parse("01-02", "03-04"); err == nil {
+		t.Fatal("expected an error for a layout missing a year")
+	}
+}
+
+func TestParseInLocationFillsMissingFromToday(t *testing.T) {
+	var d Dint
+	got, err := d.ParseInLocation("01-02", "03-04")
+	if err != nil {
+		t.Fatalf("ParseInLocation returned error: %v", err)
+	}
+	today := d.Today()
+	if d.Year(got) != d.Year(today) {
+		t.Fatalf("ParseInLocation did not fill year from Today(): got %d", got)
+	}
+	if d.Month(got) != 3 || d.Day(got) != 4 {
+		t.Fatalf("ParseInLocation got %d, want month=3 day=4", got)
+	}
+}
+
+func TestMustParsePanicsOnError(t *testing.T) {
+	var d Dint
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected MustParse to panic on an invalid value")
+		}
+	}()
+	d.MustParse("2006-01-02", "not-a-date")
+}
@@ -0,0 +1,132 @@
+package dint
+
+import "time"
+
+// Range Represents an inclusive [Start, End] interval of dints.
+type Range struct {
+	Start int
+	End   int
+}
+
+// Days Returns the number of days covered by the range, inclusive of both ends.
+func (r Range) Days() int {
+	var d Dint
+	return d.Diff(r.End, r.Start) + 1
+}
+
+// Contains Returns whether a given dint falls within the range, inclusive of both ends.
+func (r Range) Contains(param int) bool {
+	return param >= r.Start && param <= r.End
+}
+
+// Overlap Returns the intersection of two ranges. If the ranges do not overlap
+// the result is an empty range with Start after End.
+func (r Range) Overlap(other Range) Range {
+	start := r.Start
+	if other.Start > start {
+		start = other.Start
+	}
+	end := r.End
+	if other.End < end {
+		end = other.End
+	}
+	return Range{Start: start, End: end}
+}
+
+// Union Returns the ranges covering both r and other. If the two ranges overlap
+// or are adjacent, a single merged range is returned; otherwise both are
+// returned unchanged, ordered by Start.
+func (r Range) Union(other Range) []Range {
+	var d Dint
+	first, second := r, other
+	if first.Start > second.Start {
+		first, second = second, first
+	}
+	if d.AddDays(first.End, 1) >= second.Start {
+		end := first.End
+		if second.End > end {
+			end = second.End
+		}
+		return []Range{{Start: first.Start, End: end}}
+	}
+	return []Range{first, second}
+}
+
+// Split Splits the range into consecutive sub-ranges aligned to calendar
+// boundaries given by by ("month", "year" or "week"), each clipped to r.
+func (r Range) Split(by string) []Range {
+	var d Dint
+	if r.Start > r.End {
+		return nil
+	}
+
+	var parts []Range
+	cur := r.Start
+	for cur <= r.End {
+		var next int
+		switch by {
+		case "year":
+			next = d.Create(d.Year(cur)+1, 1, 1)
+		case "week":
+			next = d.AddDays(cur, 7-int(d.Weekday(cur)))
+		default: // "month"
+			next = d.AddMonths(d.FirstDayOfMonth(cur), 1)
+		}
+		end := d.AddDays(next, -1)
+		if end > r.End {
+			end = r.End
+		}
+		parts = append(parts, Range{Start: cur, End: end})
+		cur = next
+	}
+	return parts
+}
+
+// Iterate Walks the range forward from Start to End (inclusive) in steps of
+// step days, calling fn with each dint visited. Iteration stops early if fn
+// returns false. A step of zero or less is treated as 1.
+func (r Range) Iterate(step int, fn func(int) bool) {
+	var d Dint
+	if step <= 0 {
+		step = 1
+	}
+	for cur := r.Start; cur <= r.End; cur = d.AddDays(cur, step) {
+		if !fn(cur) {
+			return
+		}
+	}
+}
+
+// BusinessDaysBetween Returns the number of business days (Monday-Friday, excluding
+// the given holidays) in the inclusive range between a and b, regardless of
+// which of the two comes first.
+func (dint Dint) BusinessDaysBetween(a, b int, holidays []int) int {
+	if a > b {
+		a, b = b, a
+	}
+	holidaySet := make(map[int]bool, len(holidays))
+	for _, h := range holidays {
+		holidaySet[h] = true
+	}
+
+	count := 0
+	for cur := a; cur <= b; cur = dint.AddDays(cur, 1) {
+		wd := dint.Weekday(cur)
+		if wd != time.Sunday && wd != time.Saturday && !holidaySet[cur] {
+			count++
+		}
+	}
+	return count
+}
+
+// DaysInMonthTillDate Returns the number of days in the month of year/month, except
+// when year/month is the same as cap's year/month, in which case it returns
+// only the number of days up to and including cap's day. This is useful for
+// prorating a value over "days elapsed in the current month".
+func (dint Dint) DaysInMonthTillDate(year, month, cap int) int {
+	total := dint.DaysInAMonth(year, month)
+	if dint.Year(cap) == year && dint.Month(cap) == month {
+		return dint.Day(cap)
+	}
+	return total
+}
@@ -0,0 +1,159 @@
+package dint
+
+import "testing"
+
+func TestRangeDaysAndContains(t *testing.T) {
+	var d Dint
+	r := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 10)}
+	if got := r.Days(); got != 10 {
+		t.Fatalf("Days() = %d, want 10", got)
+	}
+	if !r.Contains(d.Create(2023, 1, 5)) {
+		t.Fatal("expected range to contain 2023-01-05")
+	}
+	if r.Contains(d.Create(2023, 1, 11)) {
+		t.Fatal("expected range not to contain 2023-01-11")
+	}
+}
+
+func TestRangeOverlap(t *testing.T) {
+	var d Dint
+	a := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 20)}
+	b := Range{Start: d.Create(2023, 1, 10), End: d.Create(2023, 1, 31)}
+	got := a.Overlap(b)
+	want := Range{Start: d.Create(2023, 1, 10), End: d.Create(2023, 1, 20)}
+	if got != want {
+		t.Fatalf("Overlap() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRangeUnionMergesAdjacentAndOverlapping(t *testing.T) {
+	var d Dint
+	jan := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 15)}
+	adjacent := Range{Start: d.Create(2023, 1, 16), End: d.Create(2023, 1, 31)}
+	merged := jan.Union(adjacent)
+	if len(merged) != 1 || merged[0] != (Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 31)}) {
+		t.Fatalf("Union(adjacent) = %+v, want a single merged range", merged)
+	}
+
+	overlapping := Range{Start: d.Create(2023, 1, 10), End: d.Create(2023, 2, 10)}
+	mergedOverlap := jan.Union(overlapping)
+	if len(mergedOverlap) != 1 || mergedOverlap[0] != (Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 2, 10)}) {
+		t.Fatalf("Union(overlapping) = %+v, want a single merged range", mergedOverlap)
+	}
+}
+
+func TestRangeUnionKeepsDisjointRangesSeparate(t *testing.T) {
+	var d Dint
+	jan := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 10)}
+	mar := Range{Start: d.Create(2023, 3, 1), End: d.Create(2023, 3, 10)}
+	got := jan.Union(mar)
+	if len(got) != 2 || got[0] != jan || got[1] != mar {
+		t.Fatalf("Union(disjoint) = %+v, want both ranges unchanged", got)
+	}
+}
+
+func TestRangeSplitByMonth(t *testing.T) {
+	var d Dint
+	r := Range{Start: d.Create(2023, 1, 15), End: d.Create(2023, 3, 10)}
+	parts := r.Split("month")
+	want := []Range{
+		{Start: d.Create(2023, 1, 15), End: d.Create(2023, 1, 31)},
+		{Start: d.Create(2023, 2, 1), End: d.Create(2023, 2, 28)},
+		{Start: d.Create(2023, 3, 1), End: d.Create(2023, 3, 10)},
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("Split(month) = %+v, want %+v", parts, want)
+	}
+	for i := range want {
+		if parts[i] != want[i] {
+			t.Fatalf("Split(month)[%d] = %+v, want %+v", i, parts[i], want[i])
+		}
+	}
+}
+
+func TestRangeSplitByYear(t *testing.T) {
+	var d Dint
+	r := Range{Start: d.Create(2022, 11, 1), End: d.Create(2024, 2, 1)}
+	parts := r.Split("year")
+	want := []Range{
+		{Start: d.Create(2022, 11, 1), End: d.Create(2022, 12, 31)},
+		{Start: d.Create(2023, 1, 1), End: d.Create(2023, 12, 31)},
+		{Start: d.Create(2024, 1, 1), End: d.Create(2024, 2, 1)},
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("Split(year) = %+v, want %+v", parts, want)
+	}
+	for i := range want {
+		if parts[i] != want[i] {
+			t.Fatalf("Split(year)[%d] = %+v, want %+v", i, parts[i], want[i])
+		}
+	}
+}
+
+func TestRangeSplitByWeek(t *testing.T) {
+	var d Dint
+	// 2023-01-01 was a Sunday; weeks run Sunday-Saturday (Weekday's 0=Sunday convention).
+	r := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 16)}
+	parts := r.Split("week")
+	want := []Range{
+		{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 7)},
+		{Start: d.Create(2023, 1, 8), End: d.Create(2023, 1, 14)},
+		{Start: d.Create(2023, 1, 15), End: d.Create(2023, 1, 16)},
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("Split(week) = %+v, want %+v", parts, want)
+	}
+	for i := range want {
+		if parts[i] != want[i] {
+			t.Fatalf("Split(week)[%d] = %+v, want %+v", i, parts[i], want[i])
+		}
+	}
+}
+
+func TestRangeIterateStopsEarly(t *testing.T) {
+	var d Dint
+	r := Range{Start: d.Create(2023, 1, 1), End: d.Create(2023, 1, 10)}
+	var visited []int
+	r.Iterate(1, func(param int) bool {
+		visited = append(visited, param)
+		return param != d.Create(2023, 1, 3)
+	})
+	want := []int{d.Create(2023, 1, 1), d.Create(2023, 1, 2), d.Create(2023, 1, 3)}
+	if len(visited) != len(want) {
+		t.Fatalf("Iterate visited %v, want %v", visited, want)
+	}
+	for i := range want {
+		if visited[i] != want[i] {
+			t.Fatalf("Iterate visited %v, want %v", visited, want)
+		}
+	}
+}
+
+func TestBusinessDaysBetweenExcludesWeekendsAndHolidays(t *testing.T) {
+	var d Dint
+	// 2023-01-01 (Sun) .. 2023-01-08 (Sun): 5 weekdays, minus New Year's observed 2023-01-02.
+	holidays := []int{d.Create(2023, 1, 2)}
+	got := d.BusinessDaysBetween(d.Create(2023, 1, 1), d.Create(2023, 1, 8), holidays)
+	if got != 4 {
+		t.Fatalf("BusinessDaysBetween = %d, want 4", got)
+	}
+}
+
+func TestBusinessDaysBetweenOrderIndependent(t *testing.T) {
+	var d Dint
+	a, b := d.Create(2023, 1, 8), d.Create(2023, 1, 1)
+	if got := d.BusinessDaysBetween(a, b, nil); got != 5 {
+		t.Fatalf("BusinessDaysBetween(reversed) = %d, want 5", got)
+	}
+}
+
+func TestDaysInMonthTillDate(t *testing.T) {
+	var d Dint
+	if got := d.DaysInMonthTillDate(2023, 1, d.Create(2023, 1, 15)); got != 15 {
+		t.Fatalf("DaysInMonthTillDate (same month) = %d, want 15", got)
+	}
+	if got := d.DaysInMonthTillDate(2023, 1, d.Create(2023, 2, 1)); got != 31 {
+		t.Fatalf("DaysInMonthTillDate (different month) = %d, want 31", got)
+	}
+}
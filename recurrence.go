@@ -0,0 +1,203 @@
+package dint
+
+import (
+	"sort"
+	"time"
+)
+
+// Frequency Is the base cadence of a Recurrence.
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// Recurrence Describes a repeating set of dints, modeled on the date-level
+// fields of an iCalendar RRULE. Freq/Interval select which periods (every
+// Interval-th day/week/month/year) are considered; ByMonth, ByMonthDay and
+// ByDay narrow which dints within a matching period qualify; BySetPos then
+// picks specific candidates out of that period's filtered set (1-indexed,
+// negative counts from the end, e.g. -1 is the last candidate).
+//
+// Count, when set, bounds the number of occurrences Between returns; Next is
+// stateless and can't enforce it on its own. Until, when set, excludes any
+// dint after it.
+type Recurrence struct {
+	Freq       Frequency
+	Interval   int
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []time.Weekday
+	BySetPos   []int
+	Count      int
+	Until      int
+}
+
+func (r Recurrence) interval() int {
+	if r.Interval <= 0 {
+		return 1
+	}
+	return r.Interval
+}
+
+// windowBounds returns the [start, end] bounds of the period containing
+// param for this recurrence's Freq: the day, the Monday-Sunday week, the
+// calendar month, or the calendar year.
+func (r Recurrence) windowBounds(dint Dint, param int) (int, int) {
+	switch r.Freq {
+	case Weekly:
+		monday := dint.AddDays(param, -((int(dint.Weekday(param)) + 6) % 7))
+		return monday, dint.AddDays(monday, 6)
+	case Monthly:
+		return dint.FirstDayOfMonth(param), dint.LastDayOfMonth(param)
+	case Yearly:
+		return dint.Create(dint.Year(param), 1, 1), dint.Create(dint.Year(param), 12, 31)
+	default: // Daily
+		return param, param
+	}
+}
+
+// periodIndex returns an index for the period containing param, counted
+// from a fixed epoch so that Interval-th periods can be identified without
+// needing an explicit recurrence start date.
+func (r Recurrence) periodIndex(dint Dint, param int) int {
+	start, _ := r.windowBounds(dint, param)
+	switch r.Freq {
+	case Weekly:
+		return floorDiv(dint.ToJulianDay(start), 7)
+	case Monthly:
+		return dint.Year(start)*12 + dint.Month(start) - 1
+	case Yearly:
+		return dint.Year(start)
+	default: // Daily
+		return dint.ToJulianDay(start)
+	}
+}
+
+func (r Recurrence) matchesFilters(dint Dint, param int) bool {
+	if len(r.ByMonth) > 0 {
+		month := dint.Month(param)
+		found := false
+		for _, m := range r.ByMonth {
+			if m == month {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 {
+		day := dint.Day(param)
+		daysInMonth := dint.DaysInAMonth(dint.Year(param), dint.Month(param))
+		found := false
+		for _, md := range r.ByMonthDay {
+			want := md
+			if want < 0 {
+				want = daysInMonth + want + 1
+			}
+			if want == day {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		weekday := dint.Weekday(param)
+		found := false
+		for _, w := range r.ByDay {
+			if w == weekday {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// candidatesInWindow returns the filtered dints within the period containing
+// param, with BySetPos applied if set.
+func (r Recurrence) candidatesInWindow(dint Dint, param int) []int {
+	start, end := r.windowBounds(dint, param)
+	var all []int
+	Range{Start: start, End: end}.Iterate(1, func(p int) bool {
+		if r.matchesFilters(dint, p) {
+			all = append(all, p)
+		}
+		return true
+	})
+	if len(r.BySetPos) == 0 || len(all) == 0 {
+		return all
+	}
+
+	var picked []int
+	for _, pos := range r.BySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(all) + pos
+		}
+		if idx >= 0 && idx < len(all) {
+			picked = append(picked, all[idx])
+		}
+	}
+	sort.Ints(picked)
+	return picked
+}
+
+// maxRecurrenceScan bounds how many periods Next will scan before giving up
+// on a recurrence whose filters never match anything.
+const maxRecurrenceScan = 100000
+
+// Next Returns the first occurrence of the recurrence strictly after after,
+// or 0 if there is none (e.g. Until has been passed).
+func (r Recurrence) Next(after int) int {
+	var dint Dint
+	step := r.interval()
+	cur := dint.AddDays(after, 1)
+
+	for i := 0; i < maxRecurrenceScan; i++ {
+		if r.Until != 0 && cur > r.Until {
+			return 0
+		}
+		if r.periodIndex(dint, cur)%step == 0 {
+			for _, candidate := range r.candidatesInWindow(dint, cur) {
+				if candidate > after && (r.Until == 0 || candidate <= r.Until) {
+					return candidate
+				}
+			}
+		}
+		_, end := r.windowBounds(dint, cur)
+		cur = dint.AddDays(end, 1)
+	}
+	return 0
+}
+
+// Between Returns every occurrence of the recurrence in the inclusive range
+// [start, end], truncated to Count entries if Count is set.
+func (r Recurrence) Between(start, end int) []int {
+	var dint Dint
+	var results []int
+	after := dint.AddDays(start, -1)
+	for {
+		next := r.Next(after)
+		if next == 0 || next > end {
+			break
+		}
+		results = append(results, next)
+		if r.Count > 0 && len(results) >= r.Count {
+			break
+		}
+		after = next
+	}
+	return results
+}
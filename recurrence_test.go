@@ -0,0 +1,69 @@
+package dint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrenceMonthlyBySetPos(t *testing.T) {
+	// Third Thursday of each month.
+	r := Recurrence{Freq: Monthly, ByDay: []time.Weekday{time.Thursday}, BySetPos: []int{3}}
+	if got := r.Next(20231101); got != 20231116 {
+		t.Fatalf("Next(2023-11-01) = %d, want 20231116", got)
+	}
+	if got := r.Next(20231116); got != 20231221 {
+		t.Fatalf("Next(2023-11-16) = %d, want 20231221 (third Thursday of December)", got)
+	}
+}
+
+func TestRecurrenceYearlyByMonthAndNegativeBySetPos(t *testing.T) {
+	// Last Friday of November each year.
+	r := Recurrence{Freq: Yearly, ByMonth: []int{11}, ByDay: []time.Weekday{time.Friday}, BySetPos: []int{-1}}
+	if got := r.Next(20230101); got != 20231124 {
+		t.Fatalf("Next(2023-01-01) = %d, want 20231124", got)
+	}
+	if got := r.Next(20231124); got != 20241129 {
+		t.Fatalf("Next(2023-11-24) = %d, want 20241129", got)
+	}
+}
+
+func TestRecurrenceWeeklyInterval(t *testing.T) {
+	var d Dint
+	r := Recurrence{Freq: Weekly, Interval: 2, ByDay: []time.Weekday{time.Monday}}
+	occurrences := r.Between(20230101, 20230301)
+	if len(occurrences) < 2 {
+		t.Fatalf("expected at least 2 occurrences, got %v", occurrences)
+	}
+	for i := 1; i < len(occurrences); i++ {
+		if gap := d.Diff(occurrences[i], occurrences[i-1]); gap != 14 {
+			t.Fatalf("expected 14-day gaps between every-other-week occurrences, got %d in %v", gap, occurrences)
+		}
+	}
+}
+
+func TestRecurrenceByMonthDayNegative(t *testing.T) {
+	// Second-to-last day of each month.
+	r := Recurrence{Freq: Monthly, ByMonthDay: []int{-2}}
+	if got := r.Next(20230201); got != 20230227 {
+		t.Fatalf("Next(2023-02-01) = %d, want 20230227 (Feb 2023 has 28 days)", got)
+	}
+}
+
+func TestRecurrenceCountLimitsBetween(t *testing.T) {
+	r := Recurrence{Freq: Daily, Count: 3}
+	occurrences := r.Between(20230101, 20230131)
+	if len(occurrences) != 3 {
+		t.Fatalf("Between with Count=3 returned %d occurrences, want 3: %v", len(occurrences), occurrences)
+	}
+}
+
+func TestRecurrenceUntilExcludesLaterOccurrences(t *testing.T) {
+	r := Recurrence{Freq: Daily, Until: 20230103}
+	occurrences := r.Between(20230101, 20230131)
+	if len(occurrences) != 3 {
+		t.Fatalf("Between with Until=2023-01-03 returned %d occurrences, want 3: %v", len(occurrences), occurrences)
+	}
+	if occurrences[len(occurrences)-1] != 20230103 {
+		t.Fatalf("last occurrence = %d, want 20230103", occurrences[len(occurrences)-1])
+	}
+}
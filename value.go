@@ -0,0 +1,114 @@
+package dint
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateInt Carries a dint as a named int value, implementing the standard
+// marshaling interfaces so dints can be used directly as JSON fields and SQL
+// column values. It uses the default (Gregorian) Dint for all conversions;
+// use the Dint methods directly if a non-default Calendar is needed.
+type DateInt int
+
+// isoLayout is the reference layout used whenever DateInt is rendered as text.
+const isoLayout = "2006-01-02"
+
+// JSONMode Selects how DateInt values are rendered to and accepted from JSON.
+type JSONMode int
+
+const (
+	// JSONInt marshals DateInt as a bare integer, e.g. 20230912. This is the default.
+	JSONInt JSONMode = iota
+	// JSONISO8601 marshals DateInt as an ISO-8601 date string, e.g. "2023-09-12".
+	JSONISO8601
+)
+
+// DefaultJSONMode Controls the JSON representation used by DateInt.MarshalJSON.
+// UnmarshalJSON always accepts either form regardless of this setting.
+var DefaultJSONMode = JSONInt
+
+var defaultDint Dint
+
+// MarshalJSON Implements json.Marshaler.
+func (d DateInt) MarshalJSON() ([]byte, error) {
+	if DefaultJSONMode == JSONISO8601 {
+		return json.Marshal(defaultDint.Format(int(d), isoLayout))
+	}
+	return []byte(strconv.Itoa(int(d))), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting both the bare integer
+// and ISO-8601 string forms.
+func (d *DateInt) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if strings.HasPrefix(s, `"`) {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		return d.UnmarshalText([]byte(str))
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("dint: cannot unmarshal %q into DateInt", s)
+	}
+	*d = DateInt(n)
+	return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, rendering the date as ISO-8601.
+func (d DateInt) MarshalText() ([]byte, error) {
+	return []byte(defaultDint.Format(int(d), isoLayout)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler, accepting either an
+// ISO-8601 date string or a bare dint integer.
+func (d *DateInt) UnmarshalText(text []byte) error {
+	n, err := parseDateIntText(string(text))
+	if err != nil {
+		return err
+	}
+	*d = DateInt(n)
+	return nil
+}
+
+// parseDateIntText parses s as either a bare dint integer or an ISO-8601 date.
+func parseDateIntText(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+	return defaultDint.Parse(isoLayout, s)
+}
+
+// Value Implements driver.Valuer, rendering the dint as a time.Time so it
+// can be written to DATE/TIMESTAMP columns.
+func (d DateInt) Value() (driver.Value, error) {
+	return defaultDint.ToDate(int(d)), nil
+}
+
+// Scan Implements sql.Scanner, accepting time.Time, []byte, string and int64
+// column values.
+func (d *DateInt) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case time.Time:
+		*d = DateInt(defaultDint.CreateFromTime(v))
+		return nil
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int64:
+		*d = DateInt(v)
+		return nil
+	default:
+		return fmt.Errorf("dint: cannot scan %T into DateInt", src)
+	}
+}
@@ -0,0 +1,42 @@
+package dint
+
+import "time"
+
+// Weekday Returns the day of week of a given dint, derived directly from the
+// Julian day number (so no round-trip through time.Time is needed).
+func (dint Dint) Weekday(param int) time.Weekday {
+	return time.Weekday((dint.ToJulianDay(param) + 1) % 7)
+}
+
+// DayOfYear Returns the 1-based ordinal day within the year of a given dint.
+func (dint Dint) DayOfYear(param int) int {
+	return dint.ToJulianDay(param) - dint.ToJulianDay(dint.Create(dint.Year(param), 1, 1)) + 1
+}
+
+// ISOWeek Returns the ISO 8601 year and week number of a given dint.
+func (dint Dint) ISOWeek(param int) (year, week int) {
+	return dint.ToDate(param).ISOWeek()
+}
+
+// CreateFromISOWeek Creates a dint from an ISO 8601 year, week (1-53) and
+// weekday (1=Monday .. 7=Sunday).
+func (dint Dint) CreateFromISOWeek(year, week, weekday int) int {
+	jan4 := dint.Create(year, 1, 4)
+	mondayOfWeek1 := dint.AddDays(jan4, -(int(dint.Weekday(jan4))+6)%7)
+	return dint.AddDays(mondayOfWeek1, (week-1)*7+(weekday-1))
+}
+
+// NthWeekdayOfMonth Returns the dint of the nth occurrence of weekday in the
+// given year and month. n=-1 means the last occurrence, n=-2 the one before
+// that, and so on.
+func (dint Dint) NthWeekdayOfMonth(year, month int, weekday time.Weekday, n int) int {
+	first := dint.Create(year, month, 1)
+	firstOccurrence := dint.AddDays(first, (int(weekday)-int(dint.Weekday(first))+7)%7)
+	if n > 0 {
+		return dint.AddDays(firstOccurrence, (n-1)*7)
+	}
+
+	last := dint.LastDayOfMonth(first)
+	lastOccurrence := dint.AddDays(last, -((int(dint.Weekday(last))-int(weekday)+7)%7))
+	return dint.AddDays(lastOccurrence, (n+1)*7)
+}
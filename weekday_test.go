@@ -0,0 +1,46 @@
+package dint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekday(t *testing.T) {
+	var d Dint
+	// 2023-09-12 was a Tuesday.
+	if wd := d.Weekday(20230912); wd != time.Tuesday {
+		t.Fatalf("Weekday(2023-09-12) = %v, want %v", wd, time.Tuesday)
+	}
+	// 2023-01-01 was a Sunday.
+	if wd := d.Weekday(20230101); wd != time.Sunday {
+		t.Fatalf("Weekday(2023-01-01) = %v, want %v", wd, time.Sunday)
+	}
+}
+
+func TestISOWeekAndCreateFromISOWeekRoundTrip(t *testing.T) {
+	var d Dint
+	year, week := d.ISOWeek(20230103)
+	if year != 2023 || week != 1 {
+		t.Fatalf("ISOWeek(2023-01-03) = (%d, %d), want (2023, 1)", year, week)
+	}
+	// Tuesday (weekday 2) of ISO week 1, 2023 is 2023-01-03.
+	if got := d.CreateFromISOWeek(2023, 1, 2); got != 20230103 {
+		t.Fatalf("CreateFromISOWeek(2023, 1, 2) = %d, want 20230103", got)
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	var d Dint
+	// Third Thursday of November 2023 is the 16th.
+	if got := d.NthWeekdayOfMonth(2023, 11, time.Thursday, 3); got != 20231116 {
+		t.Fatalf("NthWeekdayOfMonth(2023, 11, Thursday, 3) = %d, want 20231116", got)
+	}
+	// Last Thursday of November 2023 is the 30th.
+	if got := d.NthWeekdayOfMonth(2023, 11, time.Thursday, -1); got != 20231130 {
+		t.Fatalf("NthWeekdayOfMonth(2023, 11, Thursday, -1) = %d, want 20231130", got)
+	}
+	// Second-to-last Thursday of November 2023 is the 23rd.
+	if got := d.NthWeekdayOfMonth(2023, 11, time.Thursday, -2); got != 20231123 {
+		t.Fatalf("NthWeekdayOfMonth(2023, 11, Thursday, -2) = %d, want 20231123", got)
+	}
+}